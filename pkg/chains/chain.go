@@ -0,0 +1,436 @@
+package chains
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// neoAddressVersion is the version byte prepended to a NEO N3 script hash before
+// Base58Check-encoding it as an address, as defined by the NEO protocol.
+const neoAddressVersion = 0x35
+
+// ChainName is the name of a chain
+type ChainName int32
+
+const (
+	ChainName_empty ChainName = iota
+	ChainName_eth_mainnet
+	ChainName_goerli_testnet
+	ChainName_goerli_localnet
+	ChainName_sepolia_testnet
+	ChainName_bsc_mainnet
+	ChainName_bsc_testnet
+	ChainName_btc_mainnet
+	ChainName_btc_testnet
+	ChainName_btc_regtest
+	ChainName_zeta_mainnet
+	ChainName_zeta_testnet
+	ChainName_zeta_mocknet
+	ChainName_zeta_privnet
+	ChainName_firo_mainnet
+	ChainName_firo_testnet
+	ChainName_neo_mainnet
+	ChainName_neo_testnet
+	ChainName_optimism_mainnet
+	ChainName_base_sepolia
+)
+
+var ChainName_name = map[int32]string{
+	0:  "empty",
+	1:  "eth_mainnet",
+	2:  "goerli_testnet",
+	3:  "goerli_localnet",
+	4:  "sepolia_testnet",
+	5:  "bsc_mainnet",
+	6:  "bsc_testnet",
+	7:  "btc_mainnet",
+	8:  "btc_testnet",
+	9:  "btc_regtest",
+	10: "zeta_mainnet",
+	11: "zeta_testnet",
+	12: "zeta_mocknet",
+	13: "zeta_privnet",
+	14: "firo_mainnet",
+	15: "firo_testnet",
+	16: "neo_mainnet",
+	17: "neo_testnet",
+	18: "optimism_mainnet",
+	19: "base_sepolia",
+}
+
+func (c ChainName) String() string {
+	if name, ok := ChainName_name[int32(c)]; ok {
+		return name
+	}
+	return fmt.Sprintf("%d", int32(c))
+}
+
+// Network identifies the network family a chain belongs to (ethereum, bitcoin, zeta, ...)
+type Network int32
+
+const (
+	Network_eth Network = iota
+	Network_bsc
+	Network_btc
+	Network_zeta
+	Network_polygon
+	Network_optimism
+	Network_neo
+	Network_base
+)
+
+var Network_name = map[int32]string{
+	0: "eth",
+	1: "bsc",
+	2: "btc",
+	3: "zeta",
+	4: "polygon",
+	5: "optimism",
+	6: "neo",
+	7: "base",
+}
+
+func (n Network) String() string {
+	if name, ok := Network_name[int32(n)]; ok {
+		return name
+	}
+	return fmt.Sprintf("%d", int32(n))
+}
+
+// NetworkType identifies the network type (mainnet, testnet, privnet, devnet)
+type NetworkType int32
+
+const (
+	NetworkType_mainnet NetworkType = iota
+	NetworkType_testnet
+	NetworkType_privnet
+	NetworkType_devnet
+)
+
+var NetworkType_name = map[int32]string{
+	0: "mainnet",
+	1: "testnet",
+	2: "privnet",
+	3: "devnet",
+}
+
+func (n NetworkType) String() string {
+	if name, ok := NetworkType_name[int32(n)]; ok {
+		return name
+	}
+	return fmt.Sprintf("%d", int32(n))
+}
+
+// Vm identifies the virtual machine used by a chain
+type Vm int32
+
+const (
+	Vm_no_vm Vm = iota
+	Vm_evm
+	Vm_btc
+	Vm_neo_vm
+)
+
+var Vm_name = map[int32]string{
+	0: "no_vm",
+	1: "evm",
+	2: "btc",
+	3: "neo_vm",
+}
+
+func (v Vm) String() string {
+	if name, ok := Vm_name[int32(v)]; ok {
+		return name
+	}
+	return fmt.Sprintf("%d", int32(v))
+}
+
+// Consensus identifies the consensus mechanism used by a chain
+type Consensus int32
+
+const (
+	Consensus_ethereum Consensus = iota
+	Consensus_tendermint
+	Consensus_bitcoin
+	Consensus_op_stack
+	Consensus_dip2
+	Consensus_dbft
+)
+
+var Consensus_name = map[int32]string{
+	0: "ethereum",
+	1: "tendermint",
+	2: "bitcoin",
+	3: "op_stack",
+	4: "dip2",
+	5: "dbft",
+}
+
+func (c Consensus) String() string {
+	if name, ok := Consensus_name[int32(c)]; ok {
+		return name
+	}
+	return fmt.Sprintf("%d", int32(c))
+}
+
+// NeverActivatedHeight is the sentinel activation height for an EIP-2718 typed transaction
+// format an EVM chain never adopted.
+const NeverActivatedHeight = ^uint64(0)
+
+// Chain represents a chain that Zeta connects to
+type Chain struct {
+	ChainId     int64       `protobuf:"varint,1,opt,name=chain_id,proto3"`
+	ChainName   ChainName   `protobuf:"varint,2,opt,name=chain_name,proto3"`
+	Network     Network     `protobuf:"varint,3,opt,name=network,proto3"`
+	NetworkType NetworkType `protobuf:"varint,4,opt,name=network_type,proto3"`
+	Vm          Vm          `protobuf:"varint,5,opt,name=vm,proto3"`
+	Consensus   Consensus   `protobuf:"varint,6,opt,name=consensus,proto3"`
+	IsExternal  bool        `protobuf:"varint,7,opt,name=is_external,proto3"`
+
+	// AccessListHeight is the block height at which this EVM chain started accepting EIP-2930
+	// (type 0x01, access-list) transactions. Zero means supported from genesis.
+	// NeverActivatedHeight means the chain never adopted it. Unused on non-EVM chains.
+	AccessListHeight uint64 `protobuf:"varint,8,opt,name=access_list_height,proto3"`
+
+	// DynamicFeeHeight is the block height at which this EVM chain started accepting EIP-1559
+	// (type 0x02, dynamic-fee) transactions. Same zero/NeverActivatedHeight convention as
+	// AccessListHeight.
+	DynamicFeeHeight uint64 `protobuf:"varint,9,opt,name=dynamic_fee_height,proto3"`
+}
+
+// Validate checks that all enum fields on the chain hold a recognized value
+// and the chain ID is positive.
+func (c Chain) Validate() error {
+	if c.ChainId <= 0 {
+		return fmt.Errorf("chain ID must be positive")
+	}
+	if _, ok := ChainName_name[int32(c.ChainName)]; !ok {
+		return fmt.Errorf("invalid chain name %d", int32(c.ChainName))
+	}
+	if _, ok := Network_name[int32(c.Network)]; !ok {
+		return fmt.Errorf("invalid network %d", int32(c.Network))
+	}
+	if _, ok := NetworkType_name[int32(c.NetworkType)]; !ok {
+		return fmt.Errorf("invalid network type %d", int32(c.NetworkType))
+	}
+	if _, ok := Vm_name[int32(c.Vm)]; !ok {
+		return fmt.Errorf("invalid vm %d", int32(c.Vm))
+	}
+	if _, ok := Consensus_name[int32(c.Consensus)]; !ok {
+		return fmt.Errorf("invalid consensus %d", int32(c.Consensus))
+	}
+	return nil
+}
+
+// String returns a human readable identifier combining name and chain ID
+func (c Chain) String() string {
+	return fmt.Sprintf("%s-%d", c.ChainName.String(), c.ChainId)
+}
+
+// IsEmpty returns true if the chain is the zero value
+func (c Chain) IsEmpty() bool {
+	return c == Chain{}
+}
+
+// IsZetaChain returns true if the chain is the ZetaChain network itself
+func (c Chain) IsZetaChain() bool {
+	return c.Network == Network_zeta
+}
+
+// IsExternalChain returns true if the chain is an external chain connected to ZetaChain
+func (c Chain) IsExternalChain() bool {
+	return c.IsExternal
+}
+
+// InChainList returns true if the chain is in the given list of chains
+func (c Chain) InChainList(chains []Chain) bool {
+	for _, ch := range chains {
+		if ch.ChainId == c.ChainId {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportMerkleProof returns true if the chain supports inbound merkle proof verification. EVM
+// chains must hash the transaction being proven with TxHashForProof, not bare RLP, or the
+// recomputed hash silently diverges from the chain's real tx hash for any post-Berlin/London
+// typed transaction.
+func (c Chain) SupportMerkleProof() bool {
+	return IsEVMChain(c.ChainId) || IsBitcoinChain(c.ChainId)
+}
+
+// SupportsAccessList returns true if the chain accepts EIP-2930 (type 0x01) access-list
+// transactions, at any height.
+func (c Chain) SupportsAccessList() bool {
+	return c.Vm == Vm_evm && c.AccessListHeight != NeverActivatedHeight
+}
+
+// SupportsDynamicFee returns true if the chain accepts EIP-1559 (type 0x02) dynamic-fee
+// transactions, at any height.
+func (c Chain) SupportsDynamicFee() bool {
+	return c.Vm == Vm_evm && c.DynamicFeeHeight != NeverActivatedHeight
+}
+
+// TxTypeAt returns the highest EIP-2718 transaction type this chain accepts at blockHeight:
+// 0 for legacy, 1 for EIP-2930 access-list, 2 for EIP-1559 dynamic-fee. Non-EVM chains always
+// return 0, since they have no notion of typed transactions.
+func (c Chain) TxTypeAt(blockHeight uint64) uint8 {
+	if c.Vm != Vm_evm {
+		return 0
+	}
+	if c.SupportsDynamicFee() && blockHeight >= c.DynamicFeeHeight {
+		return 2
+	}
+	if c.SupportsAccessList() && blockHeight >= c.AccessListHeight {
+		return 1
+	}
+	return 0
+}
+
+// EncodeTypedTx prepends the EIP-2718 transaction type byte to an RLP-encoded typed
+// transaction payload, as go-ethereum's Transaction.MarshalBinary does for type != 0. Legacy
+// (type 0) transactions are returned unprefixed, since their wire encoding is bare RLP.
+//
+// Merkle proof verification for post-Berlin/London EVM chains must use this encoding rather
+// than bare RLP, or the computed hash will diverge from the tx's real hash for any typed
+// transaction.
+func EncodeTypedTx(txType uint8, rlpPayload []byte) []byte {
+	if txType == 0 {
+		return rlpPayload
+	}
+	return append([]byte{txType}, rlpPayload...)
+}
+
+// HashTypedTx returns the canonical hash of an EVM transaction given its EIP-2718 type and
+// RLP-encoded payload, applying the type-prefixing EncodeTypedTx requires for typed
+// transactions.
+func (c Chain) HashTypedTx(txType uint8, rlpPayload []byte) ethcommon.Hash {
+	return ethcrypto.Keccak256Hash(EncodeTypedTx(txType, rlpPayload))
+}
+
+// TxHashForProof returns the hash an EVM inbound merkle proof must match for a transaction
+// included in the block at blockHeight, given its RLP payload with the EIP-2718 type byte
+// already stripped off (as extracted from the block's transaction list). It resolves the
+// highest typed-transaction format this chain had activated at blockHeight via TxTypeAt and
+// hashes accordingly via HashTypedTx, so proofs for post-Berlin (0x01) and post-London (0x02)
+// transactions do not silently diverge from the chain's real tx hash. Only meaningful for EVM
+// chains; Bitcoin-family inbound proofs must hash via SerializeTxForHash instead.
+func (c Chain) TxHashForProof(blockHeight uint64, rlpPayload []byte) ethcommon.Hash {
+	return c.HashTypedTx(c.TxTypeAt(blockHeight), rlpPayload)
+}
+
+// EncodeAddress encodes a raw address byte slice into the chain's native address format
+func (c Chain) EncodeAddress(b []byte) (string, error) {
+	switch {
+	case IsBitcoinChain(c.ChainId):
+		addrStr := string(b)
+		btcParams, err := GetBTCChainParams(c.ChainId)
+		if err != nil {
+			return "", err
+		}
+		addr, err := btcutil.DecodeAddress(addrStr, btcParams)
+		if err != nil {
+			return "", fmt.Errorf("cannot decode address %s: %w", addrStr, err)
+		}
+		return addr.EncodeAddress(), nil
+	case IsEVMChain(c.ChainId):
+		if len(b) == 0 {
+			return "", fmt.Errorf("invalid EVM address: empty")
+		}
+		addr := ethcommon.BytesToAddress(b)
+		return addr.Hex(), nil
+	case IsNEOChain(c.ChainId):
+		return encodeNEOAddress(b)
+	default:
+		return "", fmt.Errorf("chain %d not supported", c.ChainId)
+	}
+}
+
+// DecodeAddress decodes a chain-native address string into its raw byte representation
+func (c Chain) DecodeAddress(str string) ([]byte, error) {
+	switch {
+	case IsBitcoinChain(c.ChainId):
+		return []byte(str), nil
+	case IsEVMChain(c.ChainId):
+		return ethcommon.HexToAddress(str).Bytes(), nil
+	case IsNEOChain(c.ChainId):
+		return decodeNEOAddress(str)
+	default:
+		return nil, fmt.Errorf("chain %d not supported", c.ChainId)
+	}
+}
+
+// encodeNEOAddress Base58Check-encodes a 20-byte NEO script hash into its address representation:
+// version byte || script hash || 4-byte double-SHA256 checksum of the former two.
+func encodeNEOAddress(scriptHash []byte) (string, error) {
+	if len(scriptHash) != 20 {
+		return "", fmt.Errorf("invalid NEO script hash length %d, want 20", len(scriptHash))
+	}
+	payload := append([]byte{neoAddressVersion}, scriptHash...)
+	checksum := chainhash.DoubleHashB(payload)[:4]
+	return base58.Encode(append(payload, checksum...)), nil
+}
+
+// decodeNEOAddress reverses encodeNEOAddress, validating the version byte and checksum.
+func decodeNEOAddress(addr string) ([]byte, error) {
+	decoded := base58.Decode(addr)
+	if len(decoded) != 25 {
+		return nil, fmt.Errorf("invalid NEO address %q: wrong length", addr)
+	}
+	payload, checksum := decoded[:21], decoded[21:]
+	if payload[0] != neoAddressVersion {
+		return nil, fmt.Errorf("invalid NEO address %q: wrong version byte 0x%x", addr, payload[0])
+	}
+	want := chainhash.DoubleHashB(payload)[:4]
+	if !bytes.Equal(checksum, want) {
+		return nil, fmt.Errorf("invalid NEO address %q: checksum mismatch", addr)
+	}
+	return payload[1:], nil
+}
+
+// BTCAddressFromWitnessProgram returns the bech32 BTC address for a segwit witness program
+// on this chain. It errors if the chain is not a Bitcoin chain.
+func (c Chain) BTCAddressFromWitnessProgram(witnessProgram []byte) (btcutil.Address, error) {
+	btcParams, err := GetBTCChainParams(c.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	return btcutil.NewAddressWitnessPubKeyHash(witnessProgram, btcParams)
+}
+
+// SerializeTxForHash serializes a Bitcoin-family transaction for txid computation.
+//
+// Standard Bitcoin chains use the stripped (no-witness) serialization and its DoubleSHA256,
+// identical to MsgTx.TxHash(). Chains that use the dip2 consensus (Dash/Firo-derived networks
+// that append an extra transaction payload after the standard body, e.g. for special
+// transaction types) append a non-empty extra as VarInt(len(extra)) || extra before hashing,
+// matching how those networks compute their canonical txid. An empty extra appends nothing, so
+// the hash then equals MsgTx.TxHash() on every chain.
+func (c Chain) SerializeTxForHash(tx *wire.MsgTx, extra []byte) ([]byte, chainhash.Hash, error) {
+	var buf bytes.Buffer
+	buf.Grow(tx.SerializeSize())
+	if err := tx.SerializeNoWitness(&buf); err != nil {
+		return nil, chainhash.Hash{}, fmt.Errorf("unable to serialize tx: %w", err)
+	}
+
+	if c.Consensus == Consensus_dip2 && len(extra) > 0 {
+		if err := wire.WriteVarInt(&buf, 0, uint64(len(extra))); err != nil {
+			return nil, chainhash.Hash{}, fmt.Errorf("unable to write extra payload size: %w", err)
+		}
+		if _, err := buf.Write(extra); err != nil {
+			return nil, chainhash.Hash{}, fmt.Errorf("unable to write extra payload: %w", err)
+		}
+	}
+
+	serialized := buf.Bytes()
+	hash := chainhash.DoubleHashH(serialized)
+	return serialized, hash, nil
+}
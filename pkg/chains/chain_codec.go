@@ -0,0 +1,127 @@
+package chains
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Marshal/Unmarshal/Size implement the protobuf wire format for Chain by hand, field by field,
+// since Chain is not generated from a .proto file. This lets Chain be embedded as a field of a
+// proto.Message (e.g. authority.MsgAddChain) and survive being packed into an Any for on-chain
+// broadcast, instead of being silently dropped.
+//
+// Field numbers below must stay in sync with any .proto definition added for this type later.
+const (
+	chainFieldChainID          = 1
+	chainFieldChainName        = 2
+	chainFieldNetwork          = 3
+	chainFieldNetworkType      = 4
+	chainFieldVm               = 5
+	chainFieldConsensus        = 6
+	chainFieldIsExternal       = 7
+	chainFieldAccessListHeight = 8
+	chainFieldDynamicFeeHeight = 9
+)
+
+// Marshal encodes the chain in protobuf wire format.
+func (c Chain) Marshal() ([]byte, error) {
+	buf := make([]byte, 0, c.Size())
+	buf = appendVarintField(buf, chainFieldChainID, uint64(c.ChainId))
+	buf = appendVarintField(buf, chainFieldChainName, uint64(c.ChainName))
+	buf = appendVarintField(buf, chainFieldNetwork, uint64(c.Network))
+	buf = appendVarintField(buf, chainFieldNetworkType, uint64(c.NetworkType))
+	buf = appendVarintField(buf, chainFieldVm, uint64(c.Vm))
+	buf = appendVarintField(buf, chainFieldConsensus, uint64(c.Consensus))
+	buf = appendVarintField(buf, chainFieldIsExternal, boolToUint64(c.IsExternal))
+	buf = appendVarintField(buf, chainFieldAccessListHeight, c.AccessListHeight)
+	buf = appendVarintField(buf, chainFieldDynamicFeeHeight, c.DynamicFeeHeight)
+	return buf, nil
+}
+
+// Unmarshal decodes a chain previously encoded with Marshal.
+func (c *Chain) Unmarshal(data []byte) error {
+	fields, err := decodeVarintFields(data)
+	if err != nil {
+		return fmt.Errorf("unmarshal Chain: %w", err)
+	}
+	c.ChainId = int64(fields[chainFieldChainID])
+	c.ChainName = ChainName(fields[chainFieldChainName])
+	c.Network = Network(fields[chainFieldNetwork])
+	c.NetworkType = NetworkType(fields[chainFieldNetworkType])
+	c.Vm = Vm(fields[chainFieldVm])
+	c.Consensus = Consensus(fields[chainFieldConsensus])
+	c.IsExternal = fields[chainFieldIsExternal] != 0
+	c.AccessListHeight = fields[chainFieldAccessListHeight]
+	c.DynamicFeeHeight = fields[chainFieldDynamicFeeHeight]
+	return nil
+}
+
+// Size returns the encoded size of the chain in protobuf wire format.
+func (c Chain) Size() int {
+	n := sizeVarintField(chainFieldChainID, uint64(c.ChainId))
+	n += sizeVarintField(chainFieldChainName, uint64(c.ChainName))
+	n += sizeVarintField(chainFieldNetwork, uint64(c.Network))
+	n += sizeVarintField(chainFieldNetworkType, uint64(c.NetworkType))
+	n += sizeVarintField(chainFieldVm, uint64(c.Vm))
+	n += sizeVarintField(chainFieldConsensus, uint64(c.Consensus))
+	n += sizeVarintField(chainFieldIsExternal, boolToUint64(c.IsExternal))
+	n += sizeVarintField(chainFieldAccessListHeight, c.AccessListHeight)
+	n += sizeVarintField(chainFieldDynamicFeeHeight, c.DynamicFeeHeight)
+	return n
+}
+
+func boolToUint64(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// appendVarintField appends a protobuf varint-wiretype field (tag + value) to buf.
+func appendVarintField(buf []byte, fieldNum int, value uint64) []byte {
+	var tag [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tag[:], uint64(fieldNum)<<3) // wire type 0: varint
+	buf = append(buf, tag[:n]...)
+	var val [binary.MaxVarintLen64]byte
+	n = binary.PutUvarint(val[:], value)
+	return append(buf, val[:n]...)
+}
+
+func sizeVarintField(fieldNum int, value uint64) int {
+	return sovChains(uint64(fieldNum)<<3) + sovChains(value)
+}
+
+func sovChains(v uint64) int {
+	n := 0
+	for {
+		n++
+		v >>= 7
+		if v == 0 {
+			return n
+		}
+	}
+}
+
+// decodeVarintFields reads a sequence of varint-wiretype fields into a fieldNum -> value map.
+// Every field Chain encodes with appendVarintField is wire type 0, so a single generic decode
+// loop covers all of them. Other wire types are not recognized: their values are read as varints.
+func decodeVarintFields(data []byte) (map[int]uint64, error) {
+	fields := make(map[int]uint64)
+	i := 0
+	for i < len(data) {
+		tag, n := binary.Uvarint(data[i:])
+		if n <= 0 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		i += n
+		fieldNum := int(tag >> 3)
+		value, n := binary.Uvarint(data[i:])
+		if n <= 0 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		i += n
+		fields[fieldNum] = value
+	}
+	return fields, nil
+}
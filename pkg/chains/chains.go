@@ -0,0 +1,34 @@
+package chains
+
+// Chains is a slice of Chain
+type Chains []Chain
+
+// Has returns true if the list contains the given chain
+func (chains Chains) Has(chain Chain) bool {
+	for _, c := range chains {
+		if c.ChainId == chain.ChainId {
+			return true
+		}
+	}
+	return false
+}
+
+// Distinct returns a new list with duplicate chain IDs removed, preserving order
+func (chains Chains) Distinct() Chains {
+	distinct := make(Chains, 0, len(chains))
+	for _, c := range chains {
+		if !distinct.Has(c) {
+			distinct = append(distinct, c)
+		}
+	}
+	return distinct
+}
+
+// Strings returns the String() representation of every chain in the list
+func (chains Chains) Strings() []string {
+	strs := make([]string, len(chains))
+	for i, c := range chains {
+		strs[i] = c.String()
+	}
+	return strs
+}
@@ -0,0 +1,385 @@
+package chains
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// Chain definitions for all networks Zeta natively supports.
+//
+// These are the default, compiled-in chains. They are always available even if a chain has
+// not been added to or has been overridden in the on-chain registry.
+var (
+	Ethereum = Chain{
+		ChainId:     1,
+		ChainName:   ChainName_eth_mainnet,
+		Network:     Network_eth,
+		NetworkType: NetworkType_mainnet,
+		Vm:          Vm_evm,
+		Consensus:   Consensus_ethereum,
+		IsExternal:  true,
+		// Berlin and London activation blocks on Ethereum mainnet.
+		AccessListHeight: 12244000,
+		DynamicFeeHeight: 12965000,
+	}
+
+	Goerli = Chain{
+		ChainId:     5,
+		ChainName:   ChainName_goerli_testnet,
+		Network:     Network_eth,
+		NetworkType: NetworkType_testnet,
+		Vm:          Vm_evm,
+		Consensus:   Consensus_ethereum,
+		IsExternal:  true,
+		// Berlin and London activation blocks on Goerli.
+		AccessListHeight: 4460644,
+		DynamicFeeHeight: 5062605,
+	}
+
+	GoerliLocalnet = Chain{
+		ChainId:     1337,
+		ChainName:   ChainName_goerli_localnet,
+		Network:     Network_eth,
+		NetworkType: NetworkType_privnet,
+		Vm:          Vm_evm,
+		Consensus:   Consensus_ethereum,
+		IsExternal:  true,
+		// Local devnet genesis already has both hard forks active.
+		AccessListHeight: 0,
+		DynamicFeeHeight: 0,
+	}
+
+	Sepolia = Chain{
+		ChainId:     11155111,
+		ChainName:   ChainName_sepolia_testnet,
+		Network:     Network_eth,
+		NetworkType: NetworkType_testnet,
+		Vm:          Vm_evm,
+		Consensus:   Consensus_ethereum,
+		IsExternal:  true,
+		// Sepolia launched after both hard forks were already active.
+		AccessListHeight: 0,
+		DynamicFeeHeight: 0,
+	}
+
+	BscMainnet = Chain{
+		ChainId:     56,
+		ChainName:   ChainName_bsc_mainnet,
+		Network:     Network_bsc,
+		NetworkType: NetworkType_mainnet,
+		Vm:          Vm_evm,
+		Consensus:   Consensus_ethereum,
+		IsExternal:  true,
+		// BSC's Berlin-equivalent (Planck) and London-equivalent (Luban) hard forks.
+		AccessListHeight: 13082000,
+		DynamicFeeHeight: 29020050,
+	}
+
+	BscTestnet = Chain{
+		ChainId:     97,
+		ChainName:   ChainName_bsc_testnet,
+		Network:     Network_bsc,
+		NetworkType: NetworkType_testnet,
+		Vm:          Vm_evm,
+		Consensus:   Consensus_ethereum,
+		IsExternal:  true,
+		AccessListHeight: 13837000,
+		DynamicFeeHeight: 22107423,
+	}
+
+	BitcoinMainnet = Chain{
+		ChainId:     8332,
+		ChainName:   ChainName_btc_mainnet,
+		Network:     Network_btc,
+		NetworkType: NetworkType_mainnet,
+		Vm:          Vm_btc,
+		Consensus:   Consensus_bitcoin,
+		IsExternal:  true,
+	}
+
+	BitcoinTestnet = Chain{
+		ChainId:     18332,
+		ChainName:   ChainName_btc_testnet,
+		Network:     Network_btc,
+		NetworkType: NetworkType_testnet,
+		Vm:          Vm_btc,
+		Consensus:   Consensus_bitcoin,
+		IsExternal:  true,
+	}
+
+	BitcoinRegtest = Chain{
+		ChainId:     18444,
+		ChainName:   ChainName_btc_regtest,
+		Network:     Network_btc,
+		NetworkType: NetworkType_privnet,
+		Vm:          Vm_btc,
+		Consensus:   Consensus_bitcoin,
+		IsExternal:  true,
+	}
+
+	// OptimismMainnet is an OP Stack L2. Its Bedrock genesis already post-dates Berlin and
+	// London on L1, so both EIP-2930 and EIP-1559 transactions are supported from genesis.
+	OptimismMainnet = Chain{
+		ChainId:     10,
+		ChainName:   ChainName_optimism_mainnet,
+		Network:     Network_optimism,
+		NetworkType: NetworkType_mainnet,
+		Vm:          Vm_evm,
+		Consensus:   Consensus_op_stack,
+		IsExternal:  true,
+		AccessListHeight: 0,
+		DynamicFeeHeight: 0,
+	}
+
+	// FiroMainnet is the Firo (formerly Zcoin) mainnet. Firo is a Bitcoin-derivative chain
+	// that appends an "extra payload" after the stripped transaction body (DIP2), so its
+	// canonical txid cannot be recomputed with the standard Bitcoin hashing path alone.
+	FiroMainnet = Chain{
+		ChainId:     136,
+		ChainName:   ChainName_firo_mainnet,
+		Network:     Network_btc,
+		NetworkType: NetworkType_mainnet,
+		Vm:          Vm_btc,
+		Consensus:   Consensus_dip2,
+		IsExternal:  true,
+	}
+
+	FiroTestnet = Chain{
+		ChainId:     136001,
+		ChainName:   ChainName_firo_testnet,
+		Network:     Network_btc,
+		NetworkType: NetworkType_testnet,
+		Vm:          Vm_btc,
+		Consensus:   Consensus_dip2,
+		IsExternal:  true,
+	}
+
+	ZetaChainMainnet = Chain{
+		ChainId:     7000,
+		ChainName:   ChainName_zeta_mainnet,
+		Network:     Network_zeta,
+		NetworkType: NetworkType_mainnet,
+		Vm:          Vm_evm,
+		Consensus:   Consensus_tendermint,
+		IsExternal:  false,
+	}
+
+	ZetaChainTestnet = Chain{
+		ChainId:     7001,
+		ChainName:   ChainName_zeta_testnet,
+		Network:     Network_zeta,
+		NetworkType: NetworkType_testnet,
+		Vm:          Vm_evm,
+		Consensus:   Consensus_tendermint,
+		IsExternal:  false,
+	}
+
+	ZetaChainDevnet = Chain{
+		ChainId:     7002,
+		ChainName:   ChainName_zeta_mocknet,
+		Network:     Network_zeta,
+		NetworkType: NetworkType_devnet,
+		Vm:          Vm_evm,
+		Consensus:   Consensus_tendermint,
+		IsExternal:  false,
+	}
+
+	// NeoMainnet is the NEO N3 mainnet. NEO uses its own VM (NeoVM) and a dBFT consensus
+	// mechanism; its ChainId is the network's N3 protocol magic number.
+	NeoMainnet = Chain{
+		ChainId:     860833102,
+		ChainName:   ChainName_neo_mainnet,
+		Network:     Network_neo,
+		NetworkType: NetworkType_mainnet,
+		Vm:          Vm_neo_vm,
+		Consensus:   Consensus_dbft,
+		IsExternal:  true,
+	}
+
+	NeoTestnet = Chain{
+		ChainId:     894710606,
+		ChainName:   ChainName_neo_testnet,
+		Network:     Network_neo,
+		NetworkType: NetworkType_testnet,
+		Vm:          Vm_neo_vm,
+		Consensus:   Consensus_dbft,
+		IsExternal:  true,
+	}
+
+	ZetaChainPrivnet = Chain{
+		ChainId:     7003,
+		ChainName:   ChainName_zeta_privnet,
+		Network:     Network_zeta,
+		NetworkType: NetworkType_privnet,
+		Vm:          Vm_evm,
+		Consensus:   Consensus_tendermint,
+		IsExternal:  false,
+	}
+)
+
+// DefaultChainsList returns all the default chains that are supported by zetaclient
+func DefaultChainsList() []Chain {
+	return []Chain{
+		Ethereum,
+		Goerli,
+		GoerliLocalnet,
+		Sepolia,
+		BscMainnet,
+		BscTestnet,
+		BitcoinMainnet,
+		BitcoinTestnet,
+		BitcoinRegtest,
+		OptimismMainnet,
+		FiroMainnet,
+		FiroTestnet,
+		NeoMainnet,
+		NeoTestnet,
+		ZetaChainMainnet,
+		ZetaChainTestnet,
+		ZetaChainDevnet,
+		ZetaChainPrivnet,
+	}
+}
+
+// firoMainNetParams mirrors chaincfg.MainNetParams, the network Firo mainnet was forked
+// from, but carries a distinct Name so it cannot be confused with Bitcoin mainnet by
+// GetBTCChainIDFromChainParams.
+var firoMainNetParams = func() chaincfg.Params {
+	params := chaincfg.MainNetParams
+	params.Name = "firomainnet"
+	return params
+}()
+
+// firoTestNetParams mirrors chaincfg.TestNet3Params for the same reason firoMainNetParams
+// mirrors chaincfg.MainNetParams.
+var firoTestNetParams = func() chaincfg.Params {
+	params := chaincfg.TestNet3Params
+	params.Name = "firotestnet3"
+	return params
+}()
+
+// btcChainParams maps a Bitcoin-family chain ID to its chaincfg.Params
+var btcChainParams = map[int64]*chaincfg.Params{
+	BitcoinMainnet.ChainId: &chaincfg.MainNetParams,
+	BitcoinTestnet.ChainId: &chaincfg.TestNet3Params,
+	BitcoinRegtest.ChainId: &chaincfg.RegressionNetParams,
+	FiroMainnet.ChainId:    &firoMainNetParams,
+	FiroTestnet.ChainId:    &firoTestNetParams,
+}
+
+// GetChainFromChainID returns the chain for the given chain ID, or nil if not found
+func GetChainFromChainID(chainID int64) *Chain {
+	for _, chain := range DefaultChainsList() {
+		chain := chain
+		if chain.ChainId == chainID {
+			return &chain
+		}
+	}
+	return nil
+}
+
+// ChainListByNetwork returns the list of default chains belonging to the given network
+func ChainListByNetwork(network Network) []Chain {
+	var chains []Chain
+	for _, chain := range DefaultChainsList() {
+		if chain.Network == network {
+			chains = append(chains, chain)
+		}
+	}
+	return chains
+}
+
+// ChainIDInChainList returns true if the given chain ID is in the given list of chains
+func ChainIDInChainList(chainID int64, chains []Chain) bool {
+	for _, chain := range chains {
+		if chain.ChainId == chainID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetBTCChainParams returns the chaincfg.Params for a Bitcoin-family chain ID
+func GetBTCChainParams(chainID int64) (*chaincfg.Params, error) {
+	params, found := btcChainParams[chainID]
+	if !found {
+		return nil, fmt.Errorf("chain params not found for bitcoin chain ID %d", chainID)
+	}
+	return params, nil
+}
+
+// GetBTCChainIDFromChainParams returns the chain ID for a given chaincfg.Params
+func GetBTCChainIDFromChainParams(params *chaincfg.Params) (int64, error) {
+	for chainID, p := range btcChainParams {
+		if p.Name == params.Name {
+			return chainID, nil
+		}
+	}
+	return 0, fmt.Errorf("chain ID not found for bitcoin chain params %s", params.Name)
+}
+
+// GetBTCChainParamsByName returns the chaincfg.Params registered under the given
+// chaincfg.Params.Name across all compiled-in Bitcoin-family chains, if any.
+func GetBTCChainParamsByName(name string) (*chaincfg.Params, bool) {
+	for _, params := range btcChainParams {
+		if params.Name == name {
+			return params, true
+		}
+	}
+	return nil, false
+}
+
+// IsZetaChain returns true if the chain is the ZetaChain network itself
+func IsZetaChain(chainID int64) bool {
+	chain := GetChainFromChainID(chainID)
+	if chain == nil {
+		return false
+	}
+	return chain.IsZetaChain()
+}
+
+// IsEVMChain returns true if the chain uses the EVM
+func IsEVMChain(chainID int64) bool {
+	chain := GetChainFromChainID(chainID)
+	if chain == nil {
+		return false
+	}
+	return chain.Vm == Vm_evm && !chain.IsZetaChain()
+}
+
+// IsBitcoinChain returns true if the chain is a Bitcoin-family chain
+func IsBitcoinChain(chainID int64) bool {
+	chain := GetChainFromChainID(chainID)
+	if chain == nil {
+		return false
+	}
+	return chain.Vm == Vm_btc
+}
+
+// IsNEOChain returns true if the chain is a NEO N3 chain
+func IsNEOChain(chainID int64) bool {
+	chain := GetChainFromChainID(chainID)
+	if chain == nil {
+		return false
+	}
+	return chain.Vm == Vm_neo_vm
+}
+
+// IsEthereumChain returns true if the chain is part of the Ethereum network (mainnet or testnets)
+func IsEthereumChain(chainID int64) bool {
+	chain := GetChainFromChainID(chainID)
+	if chain == nil {
+		return false
+	}
+	return chain.Network == Network_eth
+}
+
+// IsHeaderSupportedChain returns true if the chain supports block header based proofs
+func IsHeaderSupportedChain(chainID int64) bool {
+	chain := GetChainFromChainID(chainID)
+	if chain == nil {
+		return false
+	}
+	return (chain.Vm == Vm_evm || chain.Vm == Vm_btc) && !chain.IsZetaChain()
+}
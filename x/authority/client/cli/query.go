@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdChains(),
+	)
+
+	return cmd
+}
+
+// CmdChains returns the "query authority chains" command group.
+func CmdChains() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "chains",
+		Short:                      "Querying commands for the chain registry",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdChainsRegistered(),
+	)
+
+	return cmd
+}
+
+// CmdChainsRegistered returns the "query authority chains registered" command, which returns the
+// merged chain registry (on-chain overlay + compiled-in defaults).
+func CmdChainsRegistered() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registered",
+		Short: "Query the merged list of registered chains",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChainsRegistered(cmd.Context(), &types.QueryChainsRegisteredRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+	return cmd
+}
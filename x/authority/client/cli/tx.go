@@ -22,6 +22,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdUpdatePolices(),
 		CmdUpdateChainInfo(),
+		CmdAddChain(),
+		CmdRemoveChain(),
+		CmdUpdateChainParams(),
 	)
 
 	return cmd
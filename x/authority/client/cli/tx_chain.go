@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// CmdAddChain returns the "tx authority add-chain" command, which registers a brand-new chain in
+// the on-chain chain registry from a JSON-encoded chains.Chain.
+func CmdAddChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-chain [chain-json]",
+		Short: "Register a new chain in the on-chain chain registry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var chain chains.Chain
+			if err := json.Unmarshal([]byte(args[0]), &chain); err != nil {
+				return fmt.Errorf("unable to parse chain JSON: %w", err)
+			}
+
+			msg := types.NewMsgAddChain(clientCtx.GetFromAddress().String(), chain)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRemoveChain returns the "tx authority remove-chain" command, which removes a chain from the
+// on-chain chain registry.
+func CmdRemoveChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-chain [chain-id]",
+		Short: "Remove a chain from the on-chain chain registry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid chain ID %q: %w", args[0], err)
+			}
+
+			msg := types.NewMsgRemoveChain(clientCtx.GetFromAddress().String(), chainID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdUpdateChainParams returns the "tx authority update-chain-params" command, which updates the
+// per-VM parameters of an already registered chain.
+func CmdUpdateChainParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-chain-params [chain-id] [chain-params-json]",
+		Short: "Update the per-VM parameters of a registered chain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid chain ID %q: %w", args[0], err)
+			}
+
+			var params types.ChainParams
+			if err := json.Unmarshal([]byte(args[1]), &params); err != nil {
+				return fmt.Errorf("unable to parse chain params JSON: %w", err)
+			}
+
+			msg := types.NewMsgUpdateChainParams(clientCtx.GetFromAddress().String(), chainID, params)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/btcsuite/btcd/chaincfg"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// SetChain stores or overwrites a chain in the on-chain registry.
+func (k Keeper) SetChain(ctx sdk.Context, chain chains.Chain) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(chain)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.ChainKey(chain.ChainId), bz)
+}
+
+// RemoveChain deletes a chain from the on-chain registry, if present. It has no effect on the
+// compiled-in defaults returned by chains.DefaultChainsList().
+func (k Keeper) RemoveChain(ctx sdk.Context, chainID int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ChainKey(chainID))
+}
+
+// GetRegisteredChain returns a chain registered through governance, if any.
+func (k Keeper) GetRegisteredChain(ctx sdk.Context, chainID int64) (chains.Chain, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChainKey(chainID))
+	if bz == nil {
+		return chains.Chain{}, false
+	}
+	var chain chains.Chain
+	if err := json.Unmarshal(bz, &chain); err != nil {
+		panic(err)
+	}
+	return chain, true
+}
+
+// GetAllRegisteredChains returns every chain registered through governance.
+func (k Keeper) GetAllRegisteredChains(ctx sdk.Context) []chains.Chain {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainKeyPrefix)
+	defer iterator.Close()
+
+	var registered []chains.Chain
+	for ; iterator.Valid(); iterator.Next() {
+		var chain chains.Chain
+		if err := json.Unmarshal(iterator.Value(), &chain); err != nil {
+			panic(err)
+		}
+		registered = append(registered, chain)
+	}
+	return registered
+}
+
+// SetChainParams stores or overwrites the per-VM params override for a chain.
+func (k Keeper) SetChainParams(ctx sdk.Context, chainID int64, params types.ChainParams) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := json.Marshal(params)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.ChainParamsKey(chainID), bz)
+}
+
+// GetChainParams returns the per-VM params override for a chain, if any.
+func (k Keeper) GetChainParams(ctx sdk.Context, chainID int64) (types.ChainParams, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChainParamsKey(chainID))
+	if bz == nil {
+		return types.ChainParams{}, false
+	}
+	var params types.ChainParams
+	if err := json.Unmarshal(bz, &params); err != nil {
+		panic(err)
+	}
+	return params, true
+}
+
+// GetChainFromChainID returns the chain for the given chain ID, consulting the on-chain registry
+// first and falling back to the compiled-in defaults.
+func (k Keeper) GetChainFromChainID(ctx sdk.Context, chainID int64) *chains.Chain {
+	if chain, found := k.GetRegisteredChain(ctx, chainID); found {
+		return &chain
+	}
+	return chains.GetChainFromChainID(chainID)
+}
+
+// ChainListByNetwork returns every chain on the given network, merging the on-chain registry
+// with the compiled-in defaults. A registered chain overrides the default with the same ID.
+func (k Keeper) ChainListByNetwork(ctx sdk.Context, network chains.Network) []chains.Chain {
+	merged := make(map[int64]chains.Chain)
+	for _, chain := range chains.DefaultChainsList() {
+		merged[chain.ChainId] = chain
+	}
+	for _, chain := range k.GetAllRegisteredChains(ctx) {
+		merged[chain.ChainId] = chain
+	}
+
+	var list []chains.Chain
+	for _, chain := range merged {
+		if chain.Network == network {
+			list = append(list, chain)
+		}
+	}
+	return list
+}
+
+// GetBTCChainParams returns the chaincfg.Params for a Bitcoin-family chain ID, consulting the
+// on-chain registry's BTCParamsName override first and falling back to the compiled-in mapping.
+func (k Keeper) GetBTCChainParams(ctx sdk.Context, chainID int64) (*chaincfg.Params, error) {
+	if params, found := k.GetChainParams(ctx, chainID); found && params.BTCParamsName != "" {
+		btcParams, ok := chains.GetBTCChainParamsByName(params.BTCParamsName)
+		if !ok {
+			return nil, fmt.Errorf("unknown btc chain params name %q for chain ID %d", params.BTCParamsName, chainID)
+		}
+		return btcParams, nil
+	}
+	return chains.GetBTCChainParams(chainID)
+}
+
+// BTCParamsNameCollision reports whether setting name as the BTCParamsName override for chainID
+// would make GetBTCChainIDFromChainParams resolve name to more than one chain ID: either a
+// different chain's compiled-in default params, or a different chain's existing override. It
+// returns the conflicting chain ID if so. Reusing a chain's own default params Name as its
+// override is not a collision.
+func (k Keeper) BTCParamsNameCollision(ctx sdk.Context, chainID int64, name string) (int64, bool) {
+	if defaultChainID, err := chains.GetBTCChainIDFromChainParams(&chaincfg.Params{Name: name}); err == nil &&
+		defaultChainID != chainID {
+		return defaultChainID, true
+	}
+	for _, chain := range k.GetAllRegisteredChains(ctx) {
+		if chain.ChainId == chainID {
+			continue
+		}
+		if existing, found := k.GetChainParams(ctx, chain.ChainId); found && existing.BTCParamsName == name {
+			return chain.ChainId, true
+		}
+	}
+	return 0, false
+}
+
+// GetBTCChainIDFromChainParams returns the chain ID for a given chaincfg.Params, consulting the
+// on-chain registry first and falling back to the compiled-in mapping.
+func (k Keeper) GetBTCChainIDFromChainParams(ctx sdk.Context, params *chaincfg.Params) (int64, error) {
+	for _, chain := range k.GetAllRegisteredChains(ctx) {
+		chainParams, found := k.GetChainParams(ctx, chain.ChainId)
+		if found && chainParams.BTCParamsName == params.Name {
+			return chain.ChainId, nil
+		}
+	}
+	return chains.GetBTCChainIDFromChainParams(params)
+}
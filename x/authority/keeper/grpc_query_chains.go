@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ChainsRegistered returns the merged chain registry: compiled-in defaults overridden by
+// anything added or updated through governance.
+func (k Keeper) ChainsRegistered(
+	goCtx context.Context,
+	_ *types.QueryChainsRegisteredRequest,
+) (*types.QueryChainsRegisteredResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	merged := make(map[int64]chains.Chain)
+	for _, chain := range chains.DefaultChainsList() {
+		merged[chain.ChainId] = chain
+	}
+	for _, chain := range k.GetAllRegisteredChains(ctx) {
+		merged[chain.ChainId] = chain
+	}
+
+	result := make([]chains.Chain, 0, len(merged))
+	for _, chain := range merged {
+		result = append(result, chain)
+	}
+
+	return &types.QueryChainsRegisteredResponse{Chains: result}, nil
+}
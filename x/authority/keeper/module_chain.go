@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// RegisterChainServices wires the chain registry Msg and Query services into the module's
+// service router. The module's AppModule.RegisterServices should call this alongside
+// registration of the module's existing policy services so that
+// "tx authority add-chain"/"remove-chain"/"update-chain-params" and
+// "query authority chains registered" are actually routable.
+func RegisterChainServices(cfg module.Configurator, k Keeper) {
+	types.RegisterMsgServer(cfg.MsgServer(), NewMsgServerImpl(k))
+	types.RegisterQueryServer(cfg.QueryServer(), k)
+}
@@ -0,0 +1,15 @@
+package keeper
+
+import "github.com/zeta-chain/zetacore/x/authority/types"
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the chain registry message handlers for the
+// provided Keeper.
+func NewMsgServerImpl(keeper Keeper) *msgServer {
+	return &msgServer{Keeper: keeper}
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// AddChain registers a new chain in the on-chain registry. It rejects chains whose ID is
+// already registered, either through governance or as a compiled-in default.
+func (k msgServer) AddChain(goCtx context.Context, msg *types.MsgAddChain) (*types.MsgAddChainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetChainFromChainID(ctx, msg.Chain.ChainId) != nil {
+		return nil, types.ErrChainAlreadyExists.Wrapf("chain ID %d is already registered", msg.Chain.ChainId)
+	}
+
+	k.SetChain(ctx, msg.Chain)
+
+	return &types.MsgAddChainResponse{}, nil
+}
+
+// RemoveChain removes a chain from the on-chain registry.
+func (k msgServer) RemoveChain(goCtx context.Context, msg *types.MsgRemoveChain) (*types.MsgRemoveChainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetRegisteredChain(ctx, msg.ChainId); !found {
+		return nil, types.ErrChainNotFound.Wrapf("chain ID %d is not registered", msg.ChainId)
+	}
+
+	k.Keeper.RemoveChain(ctx, msg.ChainId)
+
+	return &types.MsgRemoveChainResponse{}, nil
+}
+
+// UpdateChainParams updates the per-VM parameters of an already registered chain.
+func (k msgServer) UpdateChainParams(
+	goCtx context.Context,
+	msg *types.MsgUpdateChainParams,
+) (*types.MsgUpdateChainParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetChainFromChainID(ctx, msg.ChainId) == nil {
+		return nil, types.ErrChainNotFound.Wrapf("chain ID %d is not registered", msg.ChainId)
+	}
+
+	if name := msg.ChainParams.BTCParamsName; name != "" {
+		if conflictingChainID, collides := k.BTCParamsNameCollision(ctx, msg.ChainId, name); collides {
+			return nil, types.ErrInvalidChain.Wrapf(
+				"btc chain params name %q is already used by chain ID %d", name, conflictingChainID,
+			)
+		}
+	}
+
+	k.SetChainParams(ctx, msg.ChainId, msg.ChainParams)
+
+	return &types.MsgUpdateChainParamsResponse{}, nil
+}
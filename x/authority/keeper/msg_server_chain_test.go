@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/x/authority/keeper"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+const validCreator = "zeta1l7hypmqk2yc334vc6vmdwzp5sdefygj2ufzd77"
+
+// setupKeeper returns a Keeper backed by an in-memory store, for tests that exercise the chain
+// registry end to end rather than just ValidateBasic.
+func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	memKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(memKey, storetypes.StoreTypeMemory, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+	k := keeper.NewKeeper(nil, storeKey, memKey)
+	return k, ctx
+}
+
+func TestMsgServer_UpdateChainParams_BTCParamsName(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	k.SetChain(ctx, chains.FiroMainnet)
+	k.SetChain(ctx, chains.FiroTestnet)
+
+	t.Run("resolves an override to its own default params name", func(t *testing.T) {
+		_, err := msgServer.UpdateChainParams(ctx, types.NewMsgUpdateChainParams(
+			validCreator, chains.FiroMainnet.ChainId, types.ChainParams{BTCParamsName: "firomainnet"},
+		))
+		require.NoError(t, err)
+
+		params, err := k.GetBTCChainParams(ctx, chains.FiroMainnet.ChainId)
+		require.NoError(t, err)
+		require.Equal(t, "firomainnet", params.Name)
+	})
+
+	t.Run("rejects an override colliding with another chain's default params name", func(t *testing.T) {
+		_, err := msgServer.UpdateChainParams(ctx, types.NewMsgUpdateChainParams(
+			validCreator, chains.FiroTestnet.ChainId, types.ChainParams{BTCParamsName: "firomainnet"},
+		))
+		require.Error(t, err)
+	})
+
+	t.Run("rejects an override colliding with another chain's existing override", func(t *testing.T) {
+		k.SetChain(ctx, chains.Ethereum)
+		_, err := msgServer.UpdateChainParams(ctx, types.NewMsgUpdateChainParams(
+			validCreator, chains.Ethereum.ChainId, types.ChainParams{BTCParamsName: "custom"},
+		))
+		require.NoError(t, err)
+
+		_, err = msgServer.UpdateChainParams(ctx, types.NewMsgUpdateChainParams(
+			validCreator, chains.FiroTestnet.ChainId, types.ChainParams{BTCParamsName: "custom"},
+		))
+		require.Error(t, err)
+	})
+}
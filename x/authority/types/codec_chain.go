@@ -0,0 +1,211 @@
+package types
+
+import (
+	"context"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+// The chain registry messages and query are still defined as plain Go structs rather than
+// generated from a .proto file (see tx_chain.go, query_chain.go), so the proto.Message and
+// grpc service-registration boilerplate that protoc-gen-gocosmos would normally emit is
+// hand-written here instead. This lets MsgAddChain, MsgRemoveChain and MsgUpdateChainParams be
+// packed into an Any and routed by the Msg service router, and lets ChainsRegistered be served
+// by the gRPC query router, the same way the rest of the module's messages are.
+
+func (m *MsgAddChain) Reset()         { *m = MsgAddChain{} }
+func (m *MsgAddChain) String() string { return proto.CompactTextString(m) }
+func (*MsgAddChain) ProtoMessage()    {}
+
+func (m *MsgAddChainResponse) Reset()         { *m = MsgAddChainResponse{} }
+func (m *MsgAddChainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddChainResponse) ProtoMessage()    {}
+
+func (m *MsgRemoveChain) Reset()         { *m = MsgRemoveChain{} }
+func (m *MsgRemoveChain) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveChain) ProtoMessage()    {}
+
+func (m *MsgRemoveChainResponse) Reset()         { *m = MsgRemoveChainResponse{} }
+func (m *MsgRemoveChainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveChainResponse) ProtoMessage()    {}
+
+func (m *MsgUpdateChainParams) Reset()         { *m = MsgUpdateChainParams{} }
+func (m *MsgUpdateChainParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChainParams) ProtoMessage()    {}
+
+func (m *MsgUpdateChainParamsResponse) Reset()         { *m = MsgUpdateChainParamsResponse{} }
+func (m *MsgUpdateChainParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChainParamsResponse) ProtoMessage()    {}
+
+func (m *QueryChainsRegisteredRequest) Reset()         { *m = QueryChainsRegisteredRequest{} }
+func (m *QueryChainsRegisteredRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChainsRegisteredRequest) ProtoMessage()    {}
+
+func (m *QueryChainsRegisteredResponse) Reset()         { *m = QueryChainsRegisteredResponse{} }
+func (m *QueryChainsRegisteredResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainsRegisteredResponse) ProtoMessage()    {}
+
+// RegisterChainInterfaces registers the chain registry messages as sdk.Msg implementations, so
+// that MsgAddChain, MsgRemoveChain and MsgUpdateChainParams can be packed into an Any and
+// resolved by the interface registry like the module's other messages. The module's
+// AppModuleBasic.RegisterInterfaces should call this alongside the module's existing
+// registrations, and keeper.RegisterChainServices wires the corresponding Msg/Query services.
+func RegisterChainInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddChain{},
+		&MsgRemoveChain{},
+		&MsgUpdateChainParams{},
+	)
+}
+
+// MsgServer is the server API for the chain registry Msg service.
+type MsgServer interface {
+	AddChain(context.Context, *MsgAddChain) (*MsgAddChainResponse, error)
+	RemoveChain(context.Context, *MsgRemoveChain) (*MsgRemoveChainResponse, error)
+	UpdateChainParams(context.Context, *MsgUpdateChainParams) (*MsgUpdateChainParamsResponse, error)
+}
+
+// RegisterMsgServer registers the chain registry MsgServer implementation on the module's Msg
+// service router.
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zetachain.zetacore.authority.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddChain",
+			Handler:    _Msg_AddChain_Handler,
+		},
+		{
+			MethodName: "RemoveChain",
+			Handler:    _Msg_RemoveChain_Handler,
+		},
+		{
+			MethodName: "UpdateChainParams",
+			Handler:    _Msg_UpdateChainParams_Handler,
+		},
+	},
+	Metadata: "zetachain/zetacore/authority/tx.proto",
+}
+
+func _Msg_AddChain_Handler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(MsgAddChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Msg/AddChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddChain(ctx, req.(*MsgAddChain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveChain_Handler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(MsgRemoveChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Msg/RemoveChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveChain(ctx, req.(*MsgRemoveChain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateChainParams_Handler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(MsgUpdateChainParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateChainParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Msg/UpdateChainParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateChainParams(ctx, req.(*MsgUpdateChainParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QueryServer is the server API for the chain registry Query service.
+type QueryServer interface {
+	ChainsRegistered(context.Context, *QueryChainsRegisteredRequest) (*QueryChainsRegisteredResponse, error)
+}
+
+// RegisterQueryServer registers the chain registry QueryServer implementation on the module's
+// gRPC query router.
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zetachain.zetacore.authority.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ChainsRegistered",
+			Handler:    _Query_ChainsRegistered_Handler,
+		},
+	},
+	Metadata: "zetachain/zetacore/authority/query.proto",
+}
+
+func _Query_ChainsRegistered_Handler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(QueryChainsRegisteredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainsRegistered(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Query/ChainsRegistered",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainsRegistered(ctx, req.(*QueryChainsRegisteredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
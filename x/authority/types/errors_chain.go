@@ -0,0 +1,11 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+// Chain registry specific errors. Codes are chosen not to collide with the module's existing
+// policy-related errors.
+var (
+	ErrInvalidChain       = sdkerrors.Register(ModuleName, 1100, "invalid chain")
+	ErrChainAlreadyExists = sdkerrors.Register(ModuleName, 1101, "chain already exists")
+	ErrChainNotFound      = sdkerrors.Register(ModuleName, 1102, "chain not found")
+)
@@ -0,0 +1,27 @@
+package types
+
+import "encoding/binary"
+
+// ChainKeyPrefix is the prefix under which registered chains.Chain entries are stored, keyed by
+// chain ID. This is the runtime-editable overlay on top of chains.DefaultChainsList().
+var ChainKeyPrefix = []byte{0x51}
+
+// ChainParamsKeyPrefix is the prefix under which per-chain ChainParams overrides are stored,
+// keyed by chain ID.
+var ChainParamsKeyPrefix = []byte{0x52}
+
+// ChainKey returns the store key for a chain ID.
+func ChainKey(chainID int64) []byte {
+	return append(ChainKeyPrefix, chainIDBytes(chainID)...)
+}
+
+// ChainParamsKey returns the store key for a chain ID's ChainParams override.
+func ChainParamsKey(chainID int64) []byte {
+	return append(ChainParamsKeyPrefix, chainIDBytes(chainID)...)
+}
+
+func chainIDBytes(chainID int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(chainID))
+	return bz
+}
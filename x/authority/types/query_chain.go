@@ -0,0 +1,48 @@
+package types
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+)
+
+// QueryChainsRegisteredRequest is the request for the "chains registered" query. It takes no
+// arguments: the merged registry (on-chain overlay + compiled defaults) is always returned in
+// full, mirroring chains.DefaultChainsList().
+type QueryChainsRegisteredRequest struct{}
+
+// QueryChainsRegisteredResponse returns the merged list of chains: any chain added or updated
+// through governance takes precedence over the compiled-in default with the same chain ID.
+type QueryChainsRegisteredResponse struct {
+	Chains []chains.Chain `json:"chains" protobuf:"bytes,1,rep,name=chains,proto3"`
+}
+
+// QueryClient is the client API for the authority module's Query service, covering the chain
+// registry RPC added alongside the existing policy queries.
+type QueryClient interface {
+	ChainsRegistered(ctx context.Context, in *QueryChainsRegisteredRequest, opts ...grpc.CallOption) (*QueryChainsRegisteredResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewQueryClient returns a QueryClient backed by the given connection.
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ChainsRegistered(
+	ctx context.Context,
+	in *QueryChainsRegisteredRequest,
+	opts ...grpc.CallOption,
+) (*QueryChainsRegisteredResponse, error) {
+	out := new(QueryChainsRegisteredResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Query/ChainsRegistered", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+)
+
+// Marshal/Unmarshal/Size implement the protobuf wire format for the chain registry query
+// messages by hand, for the same reason as tx_chain_codec.go.
+
+const queryChainsRegisteredResponseFieldChains = 1
+
+// QueryChainsRegisteredRequest has no fields, so it marshals to an empty payload.
+func (m *QueryChainsRegisteredRequest) Marshal() ([]byte, error) { return nil, nil }
+func (m *QueryChainsRegisteredRequest) Unmarshal(_ []byte) error { return nil }
+func (m *QueryChainsRegisteredRequest) Size() int                { return 0 }
+
+func (m *QueryChainsRegisteredResponse) Marshal() ([]byte, error) {
+	var buf []byte
+	for _, chain := range m.Chains {
+		chainBz, err := chain.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		buf = appendBytesField(buf, queryChainsRegisteredResponseFieldChains, chainBz)
+	}
+	return buf, nil
+}
+
+// Unmarshal decodes a repeated sequence of Chain submessages, each tagged with field number
+// queryChainsRegisteredResponseFieldChains, as protobuf encodes a repeated message field.
+func (m *QueryChainsRegisteredResponse) Unmarshal(data []byte) error {
+	i := 0
+	for i < len(data) {
+		tag, n := binary.Uvarint(data[i:])
+		if n <= 0 {
+			return fmt.Errorf("unmarshal QueryChainsRegisteredResponse: %w", io.ErrUnexpectedEOF)
+		}
+		i += n
+		fieldNum, wireType := int(tag>>3), tag&7
+		if fieldNum != queryChainsRegisteredResponseFieldChains || wireType != 2 {
+			return fmt.Errorf(
+				"unmarshal QueryChainsRegisteredResponse: unexpected field %d wire type %d", fieldNum, wireType,
+			)
+		}
+
+		length, n := binary.Uvarint(data[i:])
+		if n <= 0 {
+			return fmt.Errorf("unmarshal QueryChainsRegisteredResponse: %w", io.ErrUnexpectedEOF)
+		}
+		i += n
+		if i+int(length) > len(data) {
+			return fmt.Errorf("unmarshal QueryChainsRegisteredResponse: %w", io.ErrUnexpectedEOF)
+		}
+
+		var chain chains.Chain
+		if err := chain.Unmarshal(data[i : i+int(length)]); err != nil {
+			return fmt.Errorf("unmarshal QueryChainsRegisteredResponse: %w", err)
+		}
+		m.Chains = append(m.Chains, chain)
+		i += int(length)
+	}
+	return nil
+}
+
+func (m *QueryChainsRegisteredResponse) Size() int {
+	n := 0
+	for _, chain := range m.Chains {
+		chainBz, _ := chain.Marshal()
+		n += sizeBytesField(queryChainsRegisteredResponseFieldChains, chainBz)
+	}
+	return n
+}
@@ -0,0 +1,194 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+)
+
+const (
+	TypeMsgAddChain          = "AddChain"
+	TypeMsgRemoveChain       = "RemoveChain"
+	TypeMsgUpdateChainParams = "UpdateChainParams"
+)
+
+var _ sdk.Msg = &MsgAddChain{}
+var _ sdk.Msg = &MsgRemoveChain{}
+var _ sdk.Msg = &MsgUpdateChainParams{}
+
+// MsgAddChain registers a brand-new chain in the on-chain chain registry, allowing
+// operators to onboard a chain (a new Bitcoin fork, L2, or non-EVM VM) without a binary upgrade.
+type MsgAddChain struct {
+	Creator string       `json:"creator" protobuf:"bytes,1,opt,name=creator,proto3"`
+	Chain   chains.Chain `json:"chain" protobuf:"bytes,2,opt,name=chain,proto3"`
+}
+
+func NewMsgAddChain(creator string, chain chains.Chain) *MsgAddChain {
+	return &MsgAddChain{
+		Creator: creator,
+		Chain:   chain,
+	}
+}
+
+func (msg *MsgAddChain) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgAddChain) Type() string {
+	return TypeMsgAddChain
+}
+
+func (msg *MsgAddChain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAddChain) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddChain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.Chain.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidChain, "invalid chain: %s", err)
+	}
+	return nil
+}
+
+// MsgRemoveChain removes a chain from the on-chain chain registry. It does not affect the
+// compiled-in default chains, which remain available as a fallback.
+type MsgRemoveChain struct {
+	Creator string `json:"creator" protobuf:"bytes,1,opt,name=creator,proto3"`
+	ChainId int64  `json:"chain_id" protobuf:"varint,2,opt,name=chain_id,proto3"`
+}
+
+func NewMsgRemoveChain(creator string, chainID int64) *MsgRemoveChain {
+	return &MsgRemoveChain{
+		Creator: creator,
+		ChainId: chainID,
+	}
+}
+
+func (msg *MsgRemoveChain) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgRemoveChain) Type() string {
+	return TypeMsgRemoveChain
+}
+
+func (msg *MsgRemoveChain) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveChain) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveChain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ChainId <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidChain, "chain ID must be positive")
+	}
+	return nil
+}
+
+// MsgUpdateChainParams updates the per-VM parameters (a bech32 HRP, or the Name of a compiled-in
+// BTC chaincfg.Params set) of a chain that is already registered, without changing its identity
+// fields. Individual chaincfg.Params fields cannot be set: a BTC chain can only be pointed at one
+// of the existing compiled-in parameter sets.
+type MsgUpdateChainParams struct {
+	Creator     string      `json:"creator" protobuf:"bytes,1,opt,name=creator,proto3"`
+	ChainId     int64       `json:"chain_id" protobuf:"varint,2,opt,name=chain_id,proto3"`
+	ChainParams ChainParams `json:"chain_params" protobuf:"bytes,3,opt,name=chain_params,proto3"`
+}
+
+func NewMsgUpdateChainParams(creator string, chainID int64, params ChainParams) *MsgUpdateChainParams {
+	return &MsgUpdateChainParams{
+		Creator:     creator,
+		ChainId:     chainID,
+		ChainParams: params,
+	}
+}
+
+func (msg *MsgUpdateChainParams) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgUpdateChainParams) Type() string {
+	return TypeMsgUpdateChainParams
+}
+
+func (msg *MsgUpdateChainParams) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateChainParams) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateChainParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ChainId <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidChain, "chain ID must be positive")
+	}
+	return msg.ChainParams.Validate()
+}
+
+// ChainParams holds the per-VM parameters needed to operate a registered chain, on top of the
+// fields already carried by chains.Chain.
+type ChainParams struct {
+	// Bech32HRP is the bech32 human-readable part used by chains whose VM needs one (e.g. Cosmos
+	// based chains). Empty if not applicable.
+	Bech32HRP string `json:"bech32_hrp,omitempty" protobuf:"bytes,1,opt,name=bech32_hrp,proto3"`
+
+	// BTCParamsName is the chaincfg.Params.Name this chain should resolve to for Bitcoin-family
+	// chains. Empty if not applicable.
+	BTCParamsName string `json:"btc_params_name,omitempty" protobuf:"bytes,2,opt,name=btc_params_name,proto3"`
+}
+
+func (p ChainParams) Validate() error {
+	if p.Bech32HRP == "" && p.BTCParamsName == "" {
+		return fmt.Errorf("chain params must set at least one of bech32_hrp or btc_params_name")
+	}
+	return nil
+}
+
+type MsgAddChainResponse struct{}
+
+type MsgRemoveChainResponse struct{}
+
+type MsgUpdateChainParamsResponse struct{}
@@ -0,0 +1,230 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Marshal/Unmarshal/Size below implement the protobuf wire format for the chain registry
+// messages by hand, since they are not generated from a .proto file (see tx_chain.go; the query
+// messages are handled the same way in query_chain_codec.go). Without these, packing a message into an Any for on-chain broadcast would
+// silently serialize an empty payload.
+//
+// Field numbers below must stay in sync with any .proto definition added for these types later.
+
+const (
+	msgAddChainFieldCreator = 1
+	msgAddChainFieldChain   = 2
+)
+
+func (m *MsgAddChain) Marshal() ([]byte, error) {
+	buf := appendStringField(nil, msgAddChainFieldCreator, m.Creator)
+	chainBz, err := m.Chain.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return appendBytesField(buf, msgAddChainFieldChain, chainBz), nil
+}
+
+func (m *MsgAddChain) Unmarshal(data []byte) error {
+	fields, err := decodeLengthDelimitedFields(data)
+	if err != nil {
+		return fmt.Errorf("unmarshal MsgAddChain: %w", err)
+	}
+	m.Creator = string(fields[msgAddChainFieldCreator])
+	return m.Chain.Unmarshal(fields[msgAddChainFieldChain])
+}
+
+func (m *MsgAddChain) Size() int {
+	chainBz, _ := m.Chain.Marshal()
+	return sizeStringField(msgAddChainFieldCreator, m.Creator) + sizeBytesField(msgAddChainFieldChain, chainBz)
+}
+
+const msgRemoveChainFieldCreator = 1
+const msgRemoveChainFieldChainID = 2
+
+func (m *MsgRemoveChain) Marshal() ([]byte, error) {
+	buf := appendStringField(nil, msgRemoveChainFieldCreator, m.Creator)
+	buf = appendVarintField(buf, msgRemoveChainFieldChainID, uint64(m.ChainId))
+	return buf, nil
+}
+
+func (m *MsgRemoveChain) Unmarshal(data []byte) error {
+	fields, err := decodeLengthDelimitedFields(data)
+	if err != nil {
+		return fmt.Errorf("unmarshal MsgRemoveChain: %w", err)
+	}
+	m.Creator = string(fields[msgRemoveChainFieldCreator])
+	chainID, _ := binary.Uvarint(fields[msgRemoveChainFieldChainID])
+	m.ChainId = int64(chainID)
+	return nil
+}
+
+func (m *MsgRemoveChain) Size() int {
+	return sizeStringField(msgRemoveChainFieldCreator, m.Creator) +
+		sizeVarintField(msgRemoveChainFieldChainID, uint64(m.ChainId))
+}
+
+const (
+	msgUpdateChainParamsFieldCreator     = 1
+	msgUpdateChainParamsFieldChainID     = 2
+	msgUpdateChainParamsFieldChainParams = 3
+)
+
+func (m *MsgUpdateChainParams) Marshal() ([]byte, error) {
+	buf := appendStringField(nil, msgUpdateChainParamsFieldCreator, m.Creator)
+	buf = appendVarintField(buf, msgUpdateChainParamsFieldChainID, uint64(m.ChainId))
+	paramsBz, err := m.ChainParams.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return appendBytesField(buf, msgUpdateChainParamsFieldChainParams, paramsBz), nil
+}
+
+func (m *MsgUpdateChainParams) Unmarshal(data []byte) error {
+	fields, err := decodeLengthDelimitedFields(data)
+	if err != nil {
+		return fmt.Errorf("unmarshal MsgUpdateChainParams: %w", err)
+	}
+	m.Creator = string(fields[msgUpdateChainParamsFieldCreator])
+	chainID, _ := binary.Uvarint(fields[msgUpdateChainParamsFieldChainID])
+	m.ChainId = int64(chainID)
+	return m.ChainParams.Unmarshal(fields[msgUpdateChainParamsFieldChainParams])
+}
+
+func (m *MsgUpdateChainParams) Size() int {
+	paramsBz, _ := m.ChainParams.Marshal()
+	return sizeStringField(msgUpdateChainParamsFieldCreator, m.Creator) +
+		sizeVarintField(msgUpdateChainParamsFieldChainID, uint64(m.ChainId)) +
+		sizeBytesField(msgUpdateChainParamsFieldChainParams, paramsBz)
+}
+
+const (
+	chainParamsFieldBech32HRP     = 1
+	chainParamsFieldBTCParamsName = 2
+)
+
+func (p ChainParams) Marshal() ([]byte, error) {
+	buf := appendStringField(nil, chainParamsFieldBech32HRP, p.Bech32HRP)
+	buf = appendStringField(buf, chainParamsFieldBTCParamsName, p.BTCParamsName)
+	return buf, nil
+}
+
+func (p *ChainParams) Unmarshal(data []byte) error {
+	fields, err := decodeLengthDelimitedFields(data)
+	if err != nil {
+		return fmt.Errorf("unmarshal ChainParams: %w", err)
+	}
+	p.Bech32HRP = string(fields[chainParamsFieldBech32HRP])
+	p.BTCParamsName = string(fields[chainParamsFieldBTCParamsName])
+	return nil
+}
+
+func (p ChainParams) Size() int {
+	return sizeStringField(chainParamsFieldBech32HRP, p.Bech32HRP) +
+		sizeStringField(chainParamsFieldBTCParamsName, p.BTCParamsName)
+}
+
+// The three response messages carry no fields, so they marshal to an empty payload.
+
+func (m *MsgAddChainResponse) Marshal() ([]byte, error)          { return nil, nil }
+func (m *MsgAddChainResponse) Unmarshal(_ []byte) error          { return nil }
+func (m *MsgAddChainResponse) Size() int                         { return 0 }
+func (m *MsgRemoveChainResponse) Marshal() ([]byte, error)       { return nil, nil }
+func (m *MsgRemoveChainResponse) Unmarshal(_ []byte) error       { return nil }
+func (m *MsgRemoveChainResponse) Size() int                      { return 0 }
+func (m *MsgUpdateChainParamsResponse) Marshal() ([]byte, error) { return nil, nil }
+func (m *MsgUpdateChainParamsResponse) Unmarshal(_ []byte) error { return nil }
+func (m *MsgUpdateChainParamsResponse) Size() int                { return 0 }
+
+// appendVarintField appends a protobuf varint-wiretype field (tag + value) to buf.
+func appendVarintField(buf []byte, fieldNum int, value uint64) []byte {
+	var tag [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tag[:], uint64(fieldNum)<<3) // wire type 0: varint
+	buf = append(buf, tag[:n]...)
+	var val [binary.MaxVarintLen64]byte
+	n = binary.PutUvarint(val[:], value)
+	return append(buf, val[:n]...)
+}
+
+// appendBytesField appends a protobuf length-delimited field (tag + length + bytes) to buf.
+func appendBytesField(buf []byte, fieldNum int, value []byte) []byte {
+	var tag [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tag[:], uint64(fieldNum)<<3|2) // wire type 2: length-delimited
+	buf = append(buf, tag[:n]...)
+	var length [binary.MaxVarintLen64]byte
+	n = binary.PutUvarint(length[:], uint64(len(value)))
+	buf = append(buf, length[:n]...)
+	return append(buf, value...)
+}
+
+func appendStringField(buf []byte, fieldNum int, value string) []byte {
+	return appendBytesField(buf, fieldNum, []byte(value))
+}
+
+func sizeVarintField(fieldNum int, value uint64) int {
+	return sovAuthorityChain(uint64(fieldNum)<<3) + sovAuthorityChain(value)
+}
+
+func sizeBytesField(fieldNum int, value []byte) int {
+	return sovAuthorityChain(uint64(fieldNum)<<3|2) + sovAuthorityChain(uint64(len(value))) + len(value)
+}
+
+func sizeStringField(fieldNum int, value string) int {
+	return sizeBytesField(fieldNum, []byte(value))
+}
+
+func sovAuthorityChain(v uint64) int {
+	n := 0
+	for {
+		n++
+		v >>= 7
+		if v == 0 {
+			return n
+		}
+	}
+}
+
+// decodeLengthDelimitedFields reads a sequence of fields, each either varint or
+// length-delimited wire type, into a fieldNum -> raw value map. Varint fields are returned as
+// their big-endian-free raw varint bytes re-decoded by the caller via binary.Uvarint; the chain
+// registry messages only mix the two wire types within the same message (never the same field
+// number), so a single map suffices.
+func decodeLengthDelimitedFields(data []byte) (map[int][]byte, error) {
+	fields := make(map[int][]byte)
+	i := 0
+	for i < len(data) {
+		tag, n := binary.Uvarint(data[i:])
+		if n <= 0 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		i += n
+		fieldNum := int(tag >> 3)
+		wireType := tag & 7
+		switch wireType {
+		case 0: // varint
+			start := i
+			_, n := binary.Uvarint(data[i:])
+			if n <= 0 {
+				return nil, io.ErrUnexpectedEOF
+			}
+			i += n
+			fields[fieldNum] = data[start:i]
+		case 2: // length-delimited
+			length, n := binary.Uvarint(data[i:])
+			if n <= 0 {
+				return nil, io.ErrUnexpectedEOF
+			}
+			i += n
+			if i+int(length) > len(data) {
+				return nil, io.ErrUnexpectedEOF
+			}
+			fields[fieldNum] = data[i : i+int(length)]
+			i += int(length)
+		default:
+			return nil, fmt.Errorf("unsupported wire type %d", wireType)
+		}
+	}
+	return fields, nil
+}
@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+const validCreator = "zeta1l7hypmqk2yc334vc6vmdwzp5sdefygj2ufzd77"
+
+func TestMsgAddChain_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     types.MsgAddChain
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			msg:     *types.NewMsgAddChain(validCreator, chains.FiroMainnet),
+			wantErr: false,
+		},
+		{
+			name:    "invalid creator",
+			msg:     *types.NewMsgAddChain("not-a-bech32-address", chains.FiroMainnet),
+			wantErr: true,
+		},
+		{
+			name:    "invalid chain",
+			msg:     *types.NewMsgAddChain(validCreator, chains.Chain{}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveChain_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     types.MsgRemoveChain
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			msg:     *types.NewMsgRemoveChain(validCreator, chains.FiroMainnet.ChainId),
+			wantErr: false,
+		},
+		{
+			name:    "invalid creator",
+			msg:     *types.NewMsgRemoveChain("not-a-bech32-address", chains.FiroMainnet.ChainId),
+			wantErr: true,
+		},
+		{
+			name:    "invalid chain ID",
+			msg:     *types.NewMsgRemoveChain(validCreator, 0),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateChainParams_ValidateBasic(t *testing.T) {
+	validParams := types.ChainParams{BTCParamsName: "firomainnet"}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgUpdateChainParams
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			msg:     *types.NewMsgUpdateChainParams(validCreator, chains.FiroMainnet.ChainId, validParams),
+			wantErr: false,
+		},
+		{
+			name:    "invalid creator",
+			msg:     *types.NewMsgUpdateChainParams("not-a-bech32-address", chains.FiroMainnet.ChainId, validParams),
+			wantErr: true,
+		},
+		{
+			name:    "empty chain params",
+			msg:     *types.NewMsgUpdateChainParams(validCreator, chains.FiroMainnet.ChainId, types.ChainParams{}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}